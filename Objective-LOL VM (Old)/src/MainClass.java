@@ -1,5 +1,8 @@
 import java.io.File;
+import java.io.FileNotFoundException;
+import java.io.FileOutputStream;
 import java.io.IOException;
+import java.io.PrintStream;
 import java.util.ArrayList;
 import java.util.List;
 
@@ -7,8 +10,6 @@ import org.objectivelol.lang.LOLError;
 import org.objectivelol.vm.RuntimeEnvironment;
 
 public class MainClass {
-	
-	private static final String version = "0.9.0";
 
 	/**
 	 * Command line invocation in progress
@@ -24,20 +25,25 @@ public class MainClass {
 				new Getopt.LongOption("help", false, 'h'),
 				new Getopt.LongOption("version", false, 'v'),
 				new Getopt.LongOption("lib", true, 'l'),
-				new Getopt.LongOption("dir", true, 'd')
+				new Getopt.LongOption("dir", true, 'd'),
+				new Getopt.LongOption("max-runtime", true, 't'),
+				new Getopt.LongOption("output", true, 'o')
 		};
 
 		RuntimeEnvironment re = null;
 		List<File> sources = new ArrayList<File>();
 		String execDir = null;
+		String outputFile = null;
+		long maxRuntime = 0;
 
-		while((c = Getopt.getopt(args, "hvl:d:", longopts)) != null) {
+		while((c = Getopt.getopt(args, "hvl:d:t:o:", longopts)) != null) {
 			switch(c) {
 			case 'h':
 				// TODO: help
 				System.exit(0);
 			case 'v': // prints version information
-				System.out.println("Objective-LOL Virtual Machine, version " + version);
+				System.out.println("Objective-LOL Virtual Machine, version " + RuntimeEnvironment.VERSION);
+				System.out.println("Running on Java " + System.getProperty("java.version") + " (" + System.getProperty("os.name") + ", " + System.getProperty("os.arch") + ")");
 				System.exit(0);
 			case 'l': // sets the library directory
 				re = RuntimeEnvironment.getRuntime(new File(Getopt.getParam()));
@@ -45,6 +51,22 @@ public class MainClass {
 			case 'd': // sets the runtime directory
 				execDir = Getopt.getParam();
 				break;
+			case 't': // sets the maximum execution time in milliseconds
+				try {
+					maxRuntime = Long.parseLong(Getopt.getParam());
+				} catch(NumberFormatException e) {
+					maxRuntime = -1;
+				}
+
+				if(maxRuntime <= 0) {
+					System.err.println("Error: Maximum runtime must be a positive number of milliseconds");
+					System.exit(1);
+				}
+
+				break;
+			case 'o': // sends program output to a file instead of stdout
+				outputFile = Getopt.getParam();
+				break;
 			case ':': // parameter required but not found
 				System.err.println("Error: Parameter required for " + args[Getopt.getIndex()] + "\nUse -h or --help for more information about options and required parameters.");
 				System.exit(1);
@@ -102,15 +124,27 @@ public class MainClass {
 			re.setExecDir(new File(execDir));
 		}
 
+		if(outputFile != null) {
+			try {
+				System.setOut(new PrintStream(new FileOutputStream(outputFile), true));
+			} catch(FileNotFoundException e) {
+				System.err.println("Error: Cannot open output file " + outputFile);
+				System.exit(1);
+			}
+		}
+
 
 		c = null;
 		longopts = null;
 		execDir = null;
+		outputFile = null;
 		
 		re.loadSource(sources.toArray(new File[sources.size()]));
 		
 		sources = null;
 		
+		re.setMaxRuntime(maxRuntime);
+		
 		re.execute();
 	}
 
@@ -1,5 +1,8 @@
 package org.objectivelol.lang;
 
+import java.math.BigDecimal;
+import java.math.MathContext;
+
 /**
  * Class to represent a DUBBLE value in Objective-LOL.
  * Essentially a wrapper of a double value.
@@ -31,8 +34,10 @@ public class LOLDouble extends LOLNumber {
 	 * 
 	 * When casting to INTEGR, any decimal places are truncated.
 	 * 
-	 * When casting to STRIN, the value is directly converted
-	 * into a string of characters.
+	 * When casting to STRIN, the value is rounded to 15
+	 * significant digits to hide floating point noise, and is
+	 * written out without an exponent. At least one decimal
+	 * place is always kept (i.e. 1.0 rather than 1).
 	 * 
 	 * If casting to the specified type is not valid, throws
 	 * a LOLError exception.
@@ -50,7 +55,7 @@ public class LOLDouble extends LOLNumber {
 		}
 		
 		if(LOLString.TYPE_NAME.equals(type)) {
-			return new LOLString(("" + value).toUpperCase());
+			return new LOLString(format(value));
 		}
 		
 		throw new LOLError("Cannot cast to the specified type");
@@ -187,4 +192,31 @@ public class LOLDouble extends LOLNumber {
 		return new LOLDouble(value);
 	}
 
+	/**
+	 * Converts a double value into the String representation
+	 * used by Objective-LOL. NaN and infinite values are
+	 * converted directly.
+	 * 
+	 * @param value
+	 * A double representing the value to convert.
+	 * 
+	 * @return
+	 * A String representing the value, rounded to 15
+	 * significant digits, without an exponent and with
+	 * at least one decimal place.
+	 */
+	private static String format(double value) {
+		if(Double.isNaN(value) || Double.isInfinite(value)) {
+			return ("" + value).toUpperCase();
+		}
+		
+		String result = new BigDecimal(value).round(new MathContext(15)).stripTrailingZeros().toPlainString();
+		
+		if(!result.contains(".")) {
+			result += ".0";
+		}
+		
+		return result;
+	}
+
 }
@@ -8,6 +8,7 @@ import java.util.Map.Entry;
 import org.objectivelol.vm.Expression;
 import org.objectivelol.vm.Expression.Return;
 import org.objectivelol.vm.Parser;
+import org.objectivelol.vm.RuntimeEnvironment;
 import org.objectivelol.vm.ValueStruct;
 
 /**
@@ -229,6 +230,9 @@ public class LOLFunction {
 			args = new LOLValue[0];
 		}
 		
+		// stops runaway recursion once the maximum runtime set with --max-runtime has passed
+		RuntimeEnvironment.getRuntime().checkDeadline(this);
+		
 		LinkedHashMap<String, ValueStruct> arguments = null;
 		
 		// check for valid input arguments
@@ -320,7 +324,7 @@ public class LOLFunction {
 		for(Iterator<Entry<String, String>> i = inputArguments.entrySet().iterator(); i.hasNext();) {
 			Entry<String, String> e = i.next();
 			
-			if(!e.getValue().equals(args[counter].getTypeName())) {
+			if(!e.getValue().equals(LOLValue.TYPE_NAME) && !e.getValue().equals(args[counter].getTypeName())) {
 				// if the type of the argument passed in is not the same as the specified type, try to cast it to the accepted type
 				try {
 					args[counter] = args[counter].cast(e.getValue());
@@ -1,5 +1,6 @@
 package org.objectivelol.lang;
 
+import java.lang.reflect.InvocationTargetException;
 import java.lang.reflect.Method;
 
 /**
@@ -67,6 +68,13 @@ public abstract class LOLNative {
 			} else {
 				return result;
 			}
+		} catch(InvocationTargetException e) {
+			// errors raised by the native function itself are passed on as is
+			if(e.getCause() instanceof LOLError) {
+				throw (LOLError)e.getCause();
+			}
+			
+			throw new LOLError("Error in execution of native function " + methodName);
 		} catch(Exception e) {
 			throw new LOLError("Function with the specified signature not found");
 		}
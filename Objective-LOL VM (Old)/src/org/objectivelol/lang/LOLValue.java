@@ -2,6 +2,13 @@ package org.objectivelol.lang;
 
 public abstract class LOLValue {
 
+	/**
+	 * Type name accepted by NATIV function arguments that take a
+	 * value of any type. Such arguments are passed in without
+	 * being cast.
+	 */
+	public static final String TYPE_NAME = "ANYTHIN";
+
 	/**
 	 * Converts an arbitrary Java object into a LOLValue.
 	 * Conversion is currently limited to Java primitives.
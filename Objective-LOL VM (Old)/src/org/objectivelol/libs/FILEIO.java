@@ -0,0 +1,202 @@
+package org.objectivelol.libs;
+
+import java.io.Closeable;
+import java.io.File;
+import java.io.FileInputStream;
+import java.io.FileOutputStream;
+import java.io.IOException;
+import java.io.InputStream;
+import java.io.OutputStream;
+import java.util.ArrayList;
+import java.util.List;
+import java.util.Random;
+
+import org.objectivelol.lang.LOLBoolean;
+import org.objectivelol.lang.LOLError;
+import org.objectivelol.lang.LOLInteger;
+import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLNothing;
+import org.objectivelol.lang.LOLString;
+import org.objectivelol.lang.LOLValue;
+import org.objectivelol.vm.RuntimeEnvironment;
+
+public class FILEIO extends LOLNative {
+
+	private static final String tempPrefix = "olol";
+
+	private static final Random random = new Random();
+
+	private static final List<File> cleanup = new ArrayList<File>();
+
+	public static LOLNothing CLEANUP_ON_EXIT(LOLString arg) throws LOLError {
+		File f = file(arg.toString()).getAbsoluteFile();
+
+		synchronized(cleanup) {
+			if(cleanup.isEmpty()) {
+				Runtime.getRuntime().addShutdownHook(new Thread() {
+
+					@Override
+					public void run() {
+						synchronized(cleanup) {
+							for(File c : cleanup) {
+								delete(c);
+							}
+						}
+					}
+
+				});
+			}
+
+			cleanup.add(f);
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLNothing COPY(LOLString arg1, LOLString arg2) throws LOLError {
+		File source = file(arg1.toString());
+
+		if(!source.isFile()) {
+			throw new LOLError("Cannot copy " + source.getPath() + ": not a file");
+		}
+
+		File destination = file(arg2.toString());
+
+		try {
+			// opening the destination truncates it, which would empty the source first
+			if(source.getCanonicalFile().equals(destination.getCanonicalFile())) {
+				throw new LOLError("Cannot copy " + source.getPath() + " onto itself");
+			}
+		} catch(IOException e) {
+			throw new LOLError("Cannot copy " + source.getPath() + " to " + destination.getPath() + ": " + e.getMessage());
+		}
+
+		InputStream in = null;
+		OutputStream out = null;
+
+		try {
+			in = new FileInputStream(source);
+			out = new FileOutputStream(destination);
+
+			byte[] buffer = new byte[8192];
+			int read;
+
+			while((read = in.read(buffer)) != -1) {
+				out.write(buffer, 0, read);
+			}
+		} catch(IOException e) {
+			throw new LOLError("Cannot copy " + source.getPath() + " to " + destination.getPath() + ": " + e.getMessage());
+		} finally {
+			close(in);
+			close(out);
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLBoolean IS_DIR(LOLString arg) throws LOLError {
+		return (LOLBoolean)LOLValue.valueOf(file(arg.toString()).isDirectory());
+	}
+
+	public static LOLInteger MODIFIED(LOLString arg) throws LOLError {
+		File f = file(arg.toString());
+
+		if(!f.exists()) {
+			throw new LOLError("File " + f.getPath() + " does not exist");
+		}
+
+		return (LOLInteger)LOLValue.valueOf(f.lastModified());
+	}
+
+	public static LOLNothing MOVE(LOLString arg1, LOLString arg2) throws LOLError {
+		File source = file(arg1.toString());
+
+		if(!source.exists()) {
+			throw new LOLError("Cannot move " + source.getPath() + ": file does not exist");
+		}
+
+		File destination = file(arg2.toString());
+
+		if(!source.renameTo(destination)) {
+			throw new LOLError("Cannot move " + source.getPath() + " to " + destination.getPath());
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLInteger SIZE(LOLString arg) throws LOLError {
+		File f = file(arg.toString());
+
+		if(!f.exists()) {
+			throw new LOLError("File " + f.getPath() + " does not exist");
+		}
+
+		return (LOLInteger)LOLValue.valueOf(f.length());
+	}
+
+	public static LOLString TEMP_DIR() throws LOLError {
+		File parent = new File(System.getProperty("java.io.tmpdir"));
+
+		// mkdir fails if the name is taken, so the directory is never shared with another process
+		for(int i = 0; i < 100; ++i) {
+			File f = new File(parent, tempPrefix + Long.toString(random.nextLong() & Long.MAX_VALUE));
+
+			if(f.mkdir()) {
+				return new LOLString(f.getAbsolutePath());
+			}
+
+			if(!parent.isDirectory()) {
+				break;
+			}
+		}
+
+		throw new LOLError("Cannot create temporary directory in " + parent.getAbsolutePath());
+	}
+
+	public static LOLString TEMP_FILE() throws LOLError {
+		try {
+			return new LOLString(File.createTempFile(tempPrefix, null).getAbsolutePath());
+		} catch(IOException e) {
+			throw new LOLError("Cannot create temporary file: " + e.getMessage());
+		}
+	}
+
+	private static File file(String path) throws LOLError {
+		return RuntimeEnvironment.getRuntime().resolveFile(path);
+	}
+
+	private static void delete(File f) {
+		try {
+			// symbolic links are removed without touching what they point to
+			File parent = f.getParentFile();
+			boolean isLink = (parent != null && !f.getCanonicalFile().equals(new File(parent.getCanonicalFile(), f.getName())));
+
+			if(f.isDirectory() && !isLink) {
+				File[] children = f.listFiles();
+
+				if(children != null) {
+					for(File child : children) {
+						delete(child);
+					}
+				}
+			}
+		} catch(IOException e) {
+			// delete what can be deleted
+		}
+
+		f.delete();
+	}
+
+	private static void close(Closeable c) {
+		if(c == null) {
+			return;
+		}
+
+		try {
+			c.close();
+		} catch(IOException e) {
+			// nothing useful can be done if closing fails
+		}
+	}
+
+}
@@ -0,0 +1,57 @@
+package org.objectivelol.libs;
+
+import java.text.SimpleDateFormat;
+import java.util.Date;
+
+import org.objectivelol.lang.LOLError;
+import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLNothing;
+import org.objectivelol.lang.LOLString;
+
+public class LOG extends LOLNative {
+
+	private static final String[] levels = new String[] { "DEBUG", "INFO", "WARN", "ERROR" };
+
+	private static int minimumLevel = 0;
+
+	public static LOLNothing DEBUG(LOLString arg) {
+		write(0, arg.toString());
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLNothing ERROR(LOLString arg) {
+		write(3, arg.toString());
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLNothing INFO(LOLString arg) {
+		write(1, arg.toString());
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLNothing SET_LEVEL(LOLString arg) throws LOLError {
+		for(int i = 0; i < levels.length; ++i) {
+			if(levels[i].equalsIgnoreCase(arg.toString().trim())) {
+				minimumLevel = i;
+				return LOLNothing.NOTHIN;
+			}
+		}
+
+		throw new LOLError("Unknown log level " + arg.toString());
+	}
+
+	public static LOLNothing WARN(LOLString arg) {
+		write(2, arg.toString());
+		return LOLNothing.NOTHIN;
+	}
+
+	private static void write(int level, String message) {
+		if(level < minimumLevel) {
+			return;
+		}
+
+		String timestamp = new SimpleDateFormat("yyyy-MM-dd'T'HH:mm:ss.SSSZ").format(new Date());
+		System.err.println(timestamp + " [" + levels[level] + "] " + message);
+	}
+
+}
@@ -1,6 +1,7 @@
 package org.objectivelol.libs;
 
 import org.objectivelol.lang.LOLDouble;
+import org.objectivelol.lang.LOLError;
 import org.objectivelol.lang.LOLInteger;
 import org.objectivelol.lang.LOLNative;
 import org.objectivelol.lang.LOLNumber;
@@ -8,6 +9,19 @@ import org.objectivelol.lang.LOLValue;
 
 public class MATH extends LOLNative {
 
+	public static LOLNumber ABS(LOLNumber arg) throws LOLError {
+		if(arg.isLOLInteger()) {
+			// the most negative INTEGR has no positive counterpart
+			if(arg.integerValue() == Long.MIN_VALUE) {
+				throw new LOLError("Absolute value of " + arg.integerValue() + " overflows an INTEGR");
+			}
+
+			return (LOLInteger)LOLValue.valueOf(Math.abs(arg.integerValue()));
+		}
+
+		return (LOLDouble)LOLValue.valueOf(Math.abs(arg.doubleValue()));
+	}
+
 	public static LOLDouble ACOS(LOLNumber arg) {
 		return (LOLDouble)LOLValue.valueOf((Math.acos(arg.doubleValue())));
 	}
@@ -28,6 +42,10 @@ public class MATH extends LOLNative {
 		return (LOLInteger)LOLValue.valueOf(arg1.integerValue() & arg2.integerValue());
 	}
 	
+	public static LOLInteger BITNOT(LOLInteger arg) {
+		return (LOLInteger)LOLValue.valueOf(~arg.integerValue());
+	}
+
 	public static LOLInteger BITOR(LOLInteger arg1, LOLInteger arg2) {
 		return (LOLInteger)LOLValue.valueOf(arg1.integerValue() | arg2.integerValue());
 	}
@@ -40,6 +58,22 @@ public class MATH extends LOLNative {
 		return (LOLDouble)LOLValue.valueOf((Math.cbrt(arg.doubleValue())));
 	}
 
+	public static LOLNumber CLAMP(LOLNumber arg1, LOLNumber arg2, LOLNumber arg3) throws LOLError {
+		if(arg2.greaterThan(arg3).booleanValue()) {
+			throw new LOLError("Minimum of CLAMP cannot be greater than the maximum");
+		}
+
+		if(arg1.lessThan(arg2).booleanValue()) {
+			return arg2;
+		}
+
+		if(arg1.greaterThan(arg3).booleanValue()) {
+			return arg3;
+		}
+
+		return arg1;
+	}
+
 	public static LOLDouble COS(LOLNumber arg) {
 		return (LOLDouble)LOLValue.valueOf((Math.cos(arg.doubleValue())));
 	}
@@ -52,6 +86,12 @@ public class MATH extends LOLNative {
 		return (LOLDouble)LOLValue.valueOf((Math.exp(arg.doubleValue())));
 	}
 
+	public static LOLDouble LERP(LOLNumber arg1, LOLNumber arg2, LOLNumber arg3) {
+		double a = arg1.doubleValue();
+
+		return (LOLDouble)LOLValue.valueOf(a + (arg2.doubleValue() - a) * arg3.doubleValue());
+	}
+
 	public static LOLDouble LOG(LOLNumber arg) {
 		return (LOLDouble)LOLValue.valueOf((Math.log(arg.doubleValue())));
 	}
@@ -60,6 +100,18 @@ public class MATH extends LOLNative {
 		return (LOLDouble)LOLValue.valueOf((Math.log10(arg.doubleValue())));
 	}
 
+	public static LOLDouble MAP_RANGE(LOLNumber arg1, LOLNumber arg2, LOLNumber arg3, LOLNumber arg4, LOLNumber arg5) throws LOLError {
+		double inMin = arg2.doubleValue(), inMax = arg3.doubleValue();
+
+		if(inMin == inMax) {
+			throw new LOLError("Input range of MAP_RANGE cannot be empty");
+		}
+
+		double outMin = arg4.doubleValue();
+
+		return (LOLDouble)LOLValue.valueOf(outMin + (arg1.doubleValue() - inMin) * (arg5.doubleValue() - outMin) / (inMax - inMin));
+	}
+
 	public static LOLDouble POW(LOLNumber arg1, LOLNumber arg2) {
 		return (LOLDouble)LOLValue.valueOf((Math.pow(arg1.doubleValue(), arg2.doubleValue())));
 	}
@@ -68,6 +120,36 @@ public class MATH extends LOLNative {
 		return (LOLDouble)LOLValue.valueOf(Math.random());
 	}
 
+	public static LOLInteger SHL(LOLInteger arg1, LOLInteger arg2) throws LOLError {
+		long count = arg2.integerValue();
+
+		if(count < 0) {
+			throw new LOLError("Shift count cannot be negative");
+		}
+
+		// bits shifted past the top are discarded
+		return (LOLInteger)LOLValue.valueOf(count >= 64 ? 0 : arg1.integerValue() << count);
+	}
+
+	public static LOLInteger SHR(LOLInteger arg1, LOLInteger arg2) throws LOLError {
+		long count = arg2.integerValue();
+
+		if(count < 0) {
+			throw new LOLError("Shift count cannot be negative");
+		}
+
+		// arithmetic shift, the sign bit is carried in from the left
+		return (LOLInteger)LOLValue.valueOf(arg1.integerValue() >> Math.min(count, 63));
+	}
+
+	public static LOLInteger SIGN(LOLNumber arg) {
+		if(arg.isLOLInteger()) {
+			return (LOLInteger)LOLValue.valueOf(Long.signum(arg.integerValue()));
+		}
+
+		return (LOLInteger)LOLValue.valueOf((long)Math.signum(arg.doubleValue()));
+	}
+
 	public static LOLDouble SIN(LOLNumber arg) {
 		return (LOLDouble)LOLValue.valueOf((Math.sin(arg.doubleValue())));
 	}
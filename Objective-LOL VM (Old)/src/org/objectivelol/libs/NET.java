@@ -0,0 +1,55 @@
+package org.objectivelol.libs;
+
+import java.net.InetAddress;
+import java.net.UnknownHostException;
+
+import org.objectivelol.lang.LOLError;
+import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLString;
+
+public class NET extends LOLNative {
+
+	public static LOLString RESOLVE(LOLString arg) throws LOLError {
+		try {
+			return new LOLString(InetAddress.getByName(arg.toString()).getHostAddress());
+		} catch(UnknownHostException e) {
+			throw new LOLError("Cannot resolve host " + arg.toString() + ": " + e.getMessage());
+		}
+	}
+
+	public static LOLString RESOLVE_ALL(LOLString arg) throws LOLError {
+		try {
+			StringBuilder sb = new StringBuilder();
+
+			// there is no BUKKIT to return, and addresses never contain spaces
+			for(InetAddress address : InetAddress.getAllByName(arg.toString())) {
+				if(sb.length() > 0) {
+					sb.append(' ');
+				}
+
+				sb.append(address.getHostAddress());
+			}
+
+			return new LOLString(sb.toString());
+		} catch(UnknownHostException e) {
+			throw new LOLError("Cannot resolve host " + arg.toString() + ": " + e.getMessage());
+		}
+	}
+
+	public static LOLString REVERSE(LOLString arg) throws LOLError {
+		try {
+			InetAddress address = InetAddress.getByName(arg.toString());
+			String result = address.getCanonicalHostName();
+
+			// the address itself is given back when the lookup fails
+			if(result.equals(address.getHostAddress())) {
+				throw new LOLError("Cannot find a host name for " + arg.toString());
+			}
+
+			return new LOLString(result);
+		} catch(UnknownHostException e) {
+			throw new LOLError("Invalid address " + arg.toString() + ": " + e.getMessage());
+		}
+	}
+
+}
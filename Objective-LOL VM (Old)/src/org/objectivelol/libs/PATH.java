@@ -0,0 +1,73 @@
+package org.objectivelol.libs;
+
+import java.io.File;
+
+import org.objectivelol.lang.LOLBoolean;
+import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLString;
+import org.objectivelol.lang.LOLValue;
+
+public class PATH extends LOLNative {
+
+	public static LOLString BASENAME(LOLString arg) {
+		String path = trimSeparators(arg.toString());
+
+		if(path.equals("") || path.equals(File.separator)) {
+			return new LOLString(path);
+		}
+
+		return new LOLString(new File(path).getName());
+	}
+
+	public static LOLString DIRNAME(LOLString arg) {
+		String parent = new File(trimSeparators(arg.toString())).getParent();
+
+		if(parent == null) {
+			return new LOLString(arg.toString().startsWith(File.separator) ? File.separator : ".");
+		}
+
+		return new LOLString(parent);
+	}
+
+	public static LOLString EXTNAME(LOLString arg) {
+		String name = BASENAME(arg).toString();
+		int index = name.lastIndexOf('.');
+
+		// a leading dot marks a hidden file rather than an extension
+		if(index <= 0) {
+			return new LOLString("");
+		}
+
+		return new LOLString(name.substring(index));
+	}
+
+	public static LOLBoolean IS_ABSOLUTE(LOLString arg) {
+		return (LOLBoolean)LOLValue.valueOf(new File(arg.toString()).isAbsolute());
+	}
+
+	// functions have a fixed number of arguments, so more segments are joined one call at a time
+	public static LOLString JOIN(LOLString arg1, LOLString arg2) {
+		if(arg1.toString().equals("")) {
+			return new LOLString(new File(arg2.toString()).getPath());
+		}
+
+		if(arg2.toString().equals("")) {
+			return new LOLString(new File(arg1.toString()).getPath());
+		}
+
+		return new LOLString(new File(arg1.toString(), arg2.toString()).getPath());
+	}
+
+	public static LOLString SEPARATOR() {
+		return new LOLString(File.separator);
+	}
+
+	private static String trimSeparators(String path) {
+		while(path.length() > 1 && (path.endsWith(File.separator) || path.endsWith("/"))) {
+			path = path.substring(0, path.length() - 1);
+		}
+
+		return path;
+	}
+
+}
@@ -1,24 +1,48 @@
 package org.objectivelol.libs;
 
 import java.io.BufferedReader;
+import java.io.Console;
+import java.io.FileNotFoundException;
+import java.io.FileOutputStream;
 import java.io.IOException;
 import java.io.InputStreamReader;
+import java.io.PrintStream;
 
 import org.objectivelol.lang.LOLError;
 import org.objectivelol.lang.LOLNative;
 import org.objectivelol.lang.LOLNothing;
 import org.objectivelol.lang.LOLString;
 import org.objectivelol.lang.LOLValue;
+import org.objectivelol.vm.RuntimeEnvironment;
 
 public class STDIO extends LOLNative {
 	
 	private static BufferedReader br = new BufferedReader(new InputStreamReader(System.in));
 
+	private static PrintStream originalOut = null;
+
 	public static LOLNothing COMPLAIN(LOLString arg) {
 		System.err.println(arg.toString());
 		return LOLNothing.NOTHIN;
 	}
 	
+	public static LOLString GETPASS(LOLString arg) {
+		Console console = System.console();
+
+		// without a terminal there is no echo to disable
+		if(console == null) {
+			return PROMPT(arg);
+		}
+
+		char[] result = console.readPassword("%s", arg.toString());
+
+		if(result == null) {
+			return new LOLString("");
+		}
+
+		return new LOLString(new String(result));
+	}
+	
 	public static LOLString GIMMEH() {
 		try {
 			return (LOLString)LOLValue.valueOf(br.readLine()).cast(LOLString.TYPE_NAME);
@@ -29,6 +53,54 @@ public class STDIO extends LOLNative {
 		}
 	}
 	
+	public static LOLString PROMPT(LOLString arg) {
+		System.out.print(arg.toString());
+		System.out.flush();
+
+		try {
+			String result = br.readLine();
+
+			// end of input is given back as an empty STRIN
+			if(result == null) {
+				return new LOLString("");
+			}
+
+			return new LOLString(result);
+		} catch(IOException e) {
+			return new LOLString("");
+		}
+	}
+	
+	public static LOLNothing REDIRECT(LOLString arg) throws LOLError {
+		PrintStream ps;
+
+		try {
+			ps = new PrintStream(new FileOutputStream(RuntimeEnvironment.getRuntime().resolveFile(arg.toString())), true);
+		} catch(FileNotFoundException e) {
+			throw new LOLError("Cannot open output file " + arg.toString());
+		}
+
+		// remember the real stdout only the first time, so RESTORE always returns to it
+		if(originalOut == null) {
+			originalOut = System.out;
+		} else {
+			System.out.close();
+		}
+
+		System.setOut(ps);
+		return LOLNothing.NOTHIN;
+	}
+	
+	public static LOLNothing RESTORE() {
+		if(originalOut != null) {
+			System.out.close();
+			System.setOut(originalOut);
+			originalOut = null;
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+	
 	public static LOLNothing VISIBLE(LOLString arg) {
 		System.out.println(arg.toString());
 		return LOLNothing.NOTHIN;
@@ -0,0 +1,51 @@
+package org.objectivelol.libs;
+
+import org.objectivelol.lang.LOLBoolean;
+import org.objectivelol.lang.LOLError;
+import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLNothing;
+import org.objectivelol.lang.LOLNumber;
+import org.objectivelol.lang.LOLString;
+import org.objectivelol.lang.LOLValue;
+
+public class STDLIB extends LOLNative {
+
+	public static LOLNothing ASSERT(LOLBoolean arg1, LOLString arg2) throws LOLError {
+		if(!arg1.booleanValue()) {
+			throw new LOLError("Assertion failed: " + arg2.toString());
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLNothing ASSERT_EQ(LOLValue arg1, LOLValue arg2) throws LOLError {
+		// same as SAEM AS, where NOTHIN does the comparison since it cannot be cast
+		LOLBoolean equal = (arg2.isLOLNothing() ? arg2.equalTo(arg1) : arg1.equalTo(arg2));
+
+		if(!equal.booleanValue()) {
+			throw new LOLError("Assertion failed: expected " + describe(arg1) + " but got " + describe(arg2));
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	private static String describe(LOLValue value) {
+		if(value.isLOLString()) {
+			return "\"" + value.toString() + "\"";
+		}
+
+		if(value.isLOLNumber()) {
+			LOLNumber n = (LOLNumber)value;
+
+			// the STRIN cast rounds DUBBLEs, which would hide why the values differ
+			return (n.isLOLInteger() ? String.valueOf(n.integerValue()) : String.valueOf(n.doubleValue()));
+		}
+
+		try {
+			return value.cast(LOLString.TYPE_NAME).toString();
+		} catch(LOLError e) {
+			return value.getTypeName();
+		}
+	}
+
+}
@@ -0,0 +1,252 @@
+package org.objectivelol.libs;
+
+import java.math.BigDecimal;
+import java.math.RoundingMode;
+import java.util.Locale;
+
+import org.objectivelol.lang.LOLBoolean;
+import org.objectivelol.lang.LOLDouble;
+import org.objectivelol.lang.LOLError;
+import org.objectivelol.lang.LOLInteger;
+import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLNumber;
+import org.objectivelol.lang.LOLString;
+import org.objectivelol.lang.LOLValue;
+
+public class STRMANIP extends LOLNative {
+
+	public static LOLString CHAR_AT(LOLString arg1, LOLInteger arg2) throws LOLError {
+		String str = arg1.toString();
+		long index = arg2.integerValue();
+
+		if(index < 0 || index >= str.codePointCount(0, str.length())) {
+			throw new LOLError("Index " + index + " is out of range");
+		}
+
+		int offset = str.offsetByCodePoints(0, (int)index);
+		return new LOLString(new String(Character.toChars(str.codePointAt(offset))));
+	}
+
+	public static LOLString CHR(LOLInteger arg) throws LOLError {
+		long codePoint = arg.integerValue();
+
+		if(codePoint < Character.MIN_CODE_POINT || codePoint > Character.MAX_CODE_POINT) {
+			throw new LOLError("Code point " + codePoint + " is out of range");
+		}
+
+		return new LOLString(new String(Character.toChars((int)codePoint)));
+	}
+
+	public static LOLInteger DISTANCE(LOLString arg1, LOLString arg2) {
+		return (LOLInteger)LOLValue.valueOf(distance(codePoints(arg1.toString()), codePoints(arg2.toString())));
+	}
+
+	public static LOLBoolean ENDS_WITH(LOLString arg1, LOLString arg2) {
+		return (LOLBoolean)LOLValue.valueOf(arg1.toString().endsWith(arg2.toString()));
+	}
+
+	public static LOLString FORMAT_NUMBER(LOLNumber arg1, LOLInteger arg2) throws LOLError {
+		return new LOLString(formatNumber(arg1, arg2.integerValue()).toPlainString());
+	}
+
+	public static LOLString FORMAT_WITH_COMMAS(LOLNumber arg1, LOLInteger arg2) throws LOLError {
+		String str = formatNumber(arg1, arg2.integerValue()).toPlainString();
+		int start = (str.startsWith("-") ? 1 : 0);
+		int end = (str.contains(".") ? str.indexOf('.') : str.length());
+		StringBuilder sb = new StringBuilder(str.substring(0, start));
+
+		for(int i = start; i < end; ++i) {
+			if(i > start && (end - i) % 3 == 0) {
+				sb.append(',');
+			}
+
+			sb.append(str.charAt(i));
+		}
+
+		return new LOLString(sb.append(str.substring(end)).toString());
+	}
+
+	public static LOLString LOWER(LOLString arg) {
+		return new LOLString(arg.toString().toLowerCase(Locale.ROOT));
+	}
+
+	public static LOLInteger ORD(LOLString arg) throws LOLError {
+		String str = arg.toString();
+
+		if(str.codePointCount(0, str.length()) != 1) {
+			throw new LOLError("ORD requires a STRIN of exactly one character");
+		}
+
+		return (LOLInteger)LOLValue.valueOf(str.codePointAt(0));
+	}
+
+	public static LOLDouble PARSE_FLOAT(LOLString arg) throws LOLError {
+		String str = arg.toString().trim();
+
+		// Double.parseDouble also takes suffixes, hex floats, NaN and Infinity
+		if(!str.matches("[+-]?(\\d+\\.?\\d*|\\.\\d+)([eE][+-]?\\d+)?")) {
+			throw new LOLError("Cannot parse \"" + str + "\" as a DUBBLE");
+		}
+
+		try {
+			return (LOLDouble)LOLValue.valueOf(Double.parseDouble(str));
+		} catch(NumberFormatException e) {
+			throw new LOLError("Cannot parse \"" + str + "\" as a DUBBLE");
+		}
+	}
+
+	public static LOLInteger PARSE_INT(LOLString arg1, LOLInteger arg2) throws LOLError {
+		String str = arg1.toString().trim();
+		long base = arg2.integerValue();
+
+		if(base < Character.MIN_RADIX || base > Character.MAX_RADIX) {
+			throw new LOLError("Base must be between " + Character.MIN_RADIX + " and " + Character.MAX_RADIX);
+		}
+
+		// a leading + is not accepted by Long.parseLong
+		if(str.startsWith("+") && !str.startsWith("+-")) {
+			str = str.substring(1);
+		}
+
+		try {
+			return (LOLInteger)LOLValue.valueOf(Long.parseLong(str, (int)base));
+		} catch(NumberFormatException e) {
+			throw new LOLError("Cannot parse \"" + arg1.toString().trim() + "\" as an INTEGR in base " + base);
+		}
+	}
+
+	public static LOLString REPEAT(LOLString arg1, LOLInteger arg2) throws LOLError {
+		if(arg2.integerValue() < 0) {
+			throw new LOLError("Cannot repeat a STRIN a negative number of times");
+		}
+
+		StringBuilder sb = new StringBuilder();
+
+		for(long i = 0; i < arg2.integerValue(); ++i) {
+			sb.append(arg1.toString());
+		}
+
+		return new LOLString(sb.toString());
+	}
+
+	public static LOLDouble SIMILARITY(LOLString arg1, LOLString arg2) {
+		int[] a = codePoints(arg1.toString());
+		int[] b = codePoints(arg2.toString());
+		int length = Math.max(a.length, b.length);
+
+		if(length == 0) {
+			return (LOLDouble)LOLValue.valueOf(1.0);
+		}
+
+		return (LOLDouble)LOLValue.valueOf(1.0 - (double)distance(a, b) / length);
+	}
+
+	public static LOLBoolean STARTS_WITH(LOLString arg1, LOLString arg2) {
+		return (LOLBoolean)LOLValue.valueOf(arg1.toString().startsWith(arg2.toString()));
+	}
+
+	public static LOLString TITLE(LOLString arg) {
+		String str = arg.toString();
+		StringBuilder sb = new StringBuilder();
+		boolean startOfWord = true;
+
+		// words are separated by whitespace, dashes and underscores
+		for(int i = 0; i < str.length(); i = str.offsetByCodePoints(i, 1)) {
+			int c = str.codePointAt(i);
+
+			if(Character.isWhitespace(c) || c == '-' || c == '_') {
+				startOfWord = true;
+				sb.appendCodePoint(c);
+				continue;
+			}
+
+			if(startOfWord) {
+				sb.appendCodePoint(Character.toTitleCase(c));
+			} else {
+				sb.appendCodePoint(Character.toLowerCase(c));
+			}
+
+			startOfWord = false;
+		}
+
+		return new LOLString(sb.toString());
+	}
+
+	public static LOLBoolean TO_BOOL(LOLString arg) throws LOLError {
+		String str = arg.toString().trim().toUpperCase(Locale.ROOT);
+
+		if(str.equals("YEZ") || str.equals("TRUE") || str.equals("1")) {
+			return LOLBoolean.YEZ;
+		}
+
+		if(str.equals("NO") || str.equals("FALSE") || str.equals("0")) {
+			return LOLBoolean.NO;
+		}
+
+		throw new LOLError("Cannot parse \"" + arg.toString().trim() + "\" as a BOOL");
+	}
+
+	public static LOLString UPPER(LOLString arg) {
+		return new LOLString(arg.toString().toUpperCase(Locale.ROOT));
+	}
+
+	private static int[] codePoints(String str) {
+		int[] result = new int[str.codePointCount(0, str.length())];
+
+		for(int i = 0, j = 0; i < str.length(); i = str.offsetByCodePoints(i, 1), ++j) {
+			result[j] = str.codePointAt(i);
+		}
+
+		return result;
+	}
+
+	private static BigDecimal formatNumber(LOLNumber value, long decimals) throws LOLError {
+		if(decimals < 0) {
+			throw new LOLError("Number of decimal places cannot be negative");
+		}
+
+		BigDecimal result;
+
+		if(value.isLOLInteger()) {
+			result = BigDecimal.valueOf(value.integerValue());
+		} else {
+			double d = value.doubleValue();
+
+			if(Double.isNaN(d) || Double.isInfinite(d)) {
+				throw new LOLError("Cannot format " + d + " as a number");
+			}
+
+			// go through the shortest decimal form so that 2.675 rounds like it reads
+			result = new BigDecimal(Double.toString(d));
+		}
+
+		// ties are rounded away from zero
+		return result.setScale((int)Math.min(decimals, 100), RoundingMode.HALF_UP);
+	}
+
+	private static int distance(int[] a, int[] b) {
+		// Levenshtein distance, keeping only the previous row of the table
+		int[] previous = new int[b.length + 1];
+		int[] current = new int[b.length + 1];
+
+		for(int j = 0; j <= b.length; ++j) {
+			previous[j] = j;
+		}
+
+		for(int i = 1; i <= a.length; ++i) {
+			current[0] = i;
+
+			for(int j = 1; j <= b.length; ++j) {
+				int cost = (a[i - 1] == b[j - 1] ? 0 : 1);
+				current[j] = Math.min(Math.min(current[j - 1] + 1, previous[j] + 1), previous[j - 1] + cost);
+			}
+
+			int[] tmp = previous;
+			previous = current;
+			current = tmp;
+		}
+
+		return previous[b.length];
+	}
+
+}
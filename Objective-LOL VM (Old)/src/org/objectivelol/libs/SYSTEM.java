@@ -0,0 +1,165 @@
+package org.objectivelol.libs;
+
+import java.io.File;
+import java.util.Locale;
+
+import org.objectivelol.lang.LOLError;
+import org.objectivelol.lang.LOLInteger;
+import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLNothing;
+import org.objectivelol.lang.LOLString;
+import org.objectivelol.lang.LOLValue;
+import org.objectivelol.vm.RuntimeEnvironment;
+
+public class SYSTEM extends LOLNative {
+
+	public static LOLString ARCH() {
+		return new LOLString(System.getProperty("os.arch"));
+	}
+
+	public static LOLInteger CPUS() {
+		return (LOLInteger)LOLValue.valueOf(Runtime.getRuntime().availableProcessors());
+	}
+
+	public static LOLString EXPAND_ENV(LOLString arg) throws LOLError {
+		return new LOLString(expandEnv(arg.toString(), false));
+	}
+
+	public static LOLString EXPAND_ENV_STRICT(LOLString arg) throws LOLError {
+		return new LOLString(expandEnv(arg.toString(), true));
+	}
+
+	public static LOLValue FIND_EXECUTABLE(LOLString arg) {
+		String name = arg.toString();
+
+		// names containing a path are checked directly instead of searching PATH
+		if(name.contains("/") || name.contains(File.separator)) {
+			return findExecutable(new File(name));
+		}
+
+		String path = System.getenv("PATH");
+
+		if(path == null) {
+			return LOLNothing.NOTHIN;
+		}
+
+		for(String dir : path.split(File.pathSeparator)) {
+			if(dir.equals("")) {
+				dir = ".";
+			}
+
+			LOLValue result = findExecutable(new File(dir, name));
+
+			if(!result.isLOLNothing()) {
+				return result;
+			}
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLString JAVA_VERSION() {
+		return new LOLString(System.getProperty("java.version"));
+	}
+
+	public static LOLString OS() {
+		String name = System.getProperty("os.name").toLowerCase(Locale.ROOT);
+
+		// normalize the common platforms, since os.name includes version details
+		if(name.startsWith("windows")) {
+			return new LOLString("windows");
+		}
+
+		if(name.startsWith("mac") || name.startsWith("darwin")) {
+			return new LOLString("darwin");
+		}
+
+		if(name.startsWith("linux")) {
+			return new LOLString("linux");
+		}
+
+		return new LOLString(name);
+	}
+
+	public static LOLString VERSION() {
+		return new LOLString(RuntimeEnvironment.VERSION);
+	}
+
+	private static String expandEnv(String str, boolean strict) throws LOLError {
+		StringBuilder sb = new StringBuilder();
+
+		for(int i = 0; i < str.length(); ++i) {
+			char c = str.charAt(i);
+
+			if(c != '$' || i + 1 == str.length()) {
+				sb.append(c);
+				continue;
+			}
+
+			int start, end, next;
+
+			if(str.charAt(i + 1) == '{') {
+				start = i + 2;
+				end = str.indexOf('}', start);
+
+				if(end == -1) {
+					throw new LOLError("Unterminated variable reference in EXPAND_ENV");
+				}
+
+				next = end + 1;
+			} else {
+				start = end = i + 1;
+
+				while(end < str.length() && (Character.isLetterOrDigit(str.charAt(end)) || str.charAt(end) == '_')) {
+					++end;
+				}
+
+				next = end;
+			}
+
+			// a '$' not followed by a variable name is kept as is
+			if(start == end) {
+				sb.append(c);
+				continue;
+			}
+
+			String name = str.substring(start, end);
+			String value = System.getenv(name);
+
+			if(value == null) {
+				if(strict) {
+					throw new LOLError("Environment variable " + name + " is not defined");
+				}
+
+				value = "";
+			}
+
+			sb.append(value);
+			i = next - 1;
+		}
+
+		return sb.toString();
+	}
+
+	private static LOLValue findExecutable(File file) {
+		if(file.isFile() && file.canExecute()) {
+			return new LOLString(file.getAbsolutePath());
+		}
+
+		// Windows executables are usually named without their extension
+		String extensions = System.getenv("PATHEXT");
+
+		if(extensions != null && System.getProperty("os.name").startsWith("Windows")) {
+			for(String ext : extensions.split(File.pathSeparator)) {
+				File f = new File(file.getPath() + ext);
+
+				if(f.isFile()) {
+					return new LOLString(f.getAbsolutePath());
+				}
+			}
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+}
@@ -0,0 +1,111 @@
+package org.objectivelol.libs;
+
+import org.objectivelol.lang.LOLBoolean;
+import org.objectivelol.lang.LOLInteger;
+import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLNothing;
+import org.objectivelol.lang.LOLString;
+import org.objectivelol.lang.LOLValue;
+
+public class TERM extends LOLNative {
+
+	private static final int progressWidth = 30;
+
+	public static LOLString BLUE(LOLString arg) {
+		return new LOLString(style("34", arg.toString()));
+	}
+
+	public static LOLString BOLD(LOLString arg) {
+		return new LOLString(style("1", arg.toString()));
+	}
+
+	public static LOLBoolean COLORS_ENABLED() {
+		return (LOLBoolean)LOLValue.valueOf(colorsEnabled());
+	}
+
+	public static LOLString CYAN(LOLString arg) {
+		return new LOLString(style("36", arg.toString()));
+	}
+
+	public static LOLString DIM(LOLString arg) {
+		return new LOLString(style("2", arg.toString()));
+	}
+
+	public static LOLString GREEN(LOLString arg) {
+		return new LOLString(style("32", arg.toString()));
+	}
+
+	public static LOLBoolean IS_TTY() {
+		// Java only exposes a console when both stdin and stdout are terminals
+		return (LOLBoolean)LOLValue.valueOf(System.console() != null);
+	}
+
+	public static LOLString MAGENTA(LOLString arg) {
+		return new LOLString(style("35", arg.toString()));
+	}
+
+	public static LOLInteger PROGRESS_BAR(LOLInteger arg1, LOLInteger arg2, LOLInteger arg3) {
+		long total = Math.max(arg2.integerValue(), 1);
+		long current = Math.max(0, Math.min(arg1.integerValue(), total));
+		long last = arg3.integerValue();
+		int percent = (int)(current * 100 / total);
+
+		if(System.console() != null) {
+			int filled = percent * progressWidth / 100;
+			StringBuilder sb = new StringBuilder("\r[");
+
+			for(int i = 0; i < progressWidth; ++i) {
+				sb.append(i < filled ? '#' : '-');
+			}
+
+			System.err.print(sb.append("] ").append(percent).append('%').toString());
+			System.err.flush();
+		} else if(last < 0 || percent / 10 > last / 10) {
+			// not a terminal, so only report every 10% on its own line
+			System.err.println(percent + "%");
+		}
+
+		// the PROGRESS object keeps this as its state for the next call
+		return (LOLInteger)LOLValue.valueOf(percent);
+	}
+
+	public static LOLNothing PROGRESS_DONE(LOLInteger arg) {
+		if(System.console() != null && arg.integerValue() >= 0) {
+			System.err.println();
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLString RED(LOLString arg) {
+		return new LOLString(style("31", arg.toString()));
+	}
+
+	public static LOLString UNDERLINE(LOLString arg) {
+		return new LOLString(style("4", arg.toString()));
+	}
+
+	public static LOLString YELLOW(LOLString arg) {
+		return new LOLString(style("33", arg.toString()));
+	}
+
+	private static boolean colorsEnabled() {
+		// see https://no-color.org
+		String noColor = System.getenv("NO_COLOR");
+
+		if(noColor != null && !noColor.equals("")) {
+			return false;
+		}
+
+		return System.console() != null;
+	}
+
+	private static String style(String code, String str) {
+		if(!colorsEnabled()) {
+			return str;
+		}
+
+		return "\u001B[" + code + "m" + str + "\u001B[0m";
+	}
+
+}
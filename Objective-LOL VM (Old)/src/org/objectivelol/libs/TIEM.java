@@ -2,12 +2,50 @@ package org.objectivelol.libs;
 
 import org.objectivelol.lang.LOLInteger;
 import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLNothing;
 import org.objectivelol.lang.LOLValue;
 
 public class TIEM extends LOLNative {
 
+	// System.nanoTime() may be negative, so NANO_TICK and TICK count from class load instead
+	private static final long origin = System.nanoTime();
+
+	public static LOLNothing NANO_SLEEP(LOLInteger arg) {
+		long nanos = arg.integerValue();
+
+		if(nanos > 0) {
+			try {
+				Thread.sleep(nanos / 1000000, (int)(nanos % 1000000));
+			} catch(InterruptedException e) {
+				Thread.currentThread().interrupt();
+			}
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLInteger NANO_TICK() {
+		return (LOLInteger)LOLValue.valueOf(System.nanoTime() - origin);
+	}
+
 	public static LOLInteger NAO() {
 		return (LOLInteger)LOLValue.valueOf(System.currentTimeMillis());
 	}
+
+	public static LOLNothing SLEEP(LOLInteger arg) {
+		if(arg.integerValue() > 0) {
+			try {
+				Thread.sleep(arg.integerValue());
+			} catch(InterruptedException e) {
+				Thread.currentThread().interrupt();
+			}
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	public static LOLInteger TICK() {
+		return (LOLInteger)LOLValue.valueOf((System.nanoTime() - origin) / 1000000);
+	}
 	
 }
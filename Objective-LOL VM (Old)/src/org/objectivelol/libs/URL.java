@@ -0,0 +1,143 @@
+package org.objectivelol.libs;
+
+import java.io.UnsupportedEncodingException;
+import java.net.URI;
+import java.net.URISyntaxException;
+import java.net.URLDecoder;
+import java.net.URLEncoder;
+
+import org.objectivelol.lang.LOLError;
+import org.objectivelol.lang.LOLNative;
+import org.objectivelol.lang.LOLNothing;
+import org.objectivelol.lang.LOLString;
+import org.objectivelol.lang.LOLValue;
+
+public class URL extends LOLNative {
+
+	public static LOLString COMPONENT(LOLString arg1, LOLString arg2) throws LOLError {
+		URI uri;
+
+		try {
+			uri = new URI(arg1.toString());
+		} catch(URISyntaxException e) {
+			throw new LOLError("Invalid URL: " + e.getMessage());
+		}
+
+		String name = arg2.toString();
+		String result;
+
+		if(name.equals("SCHEME")) {
+			result = uri.getScheme();
+		} else if(name.equals("HOST")) {
+			result = uri.getHost();
+		} else if(name.equals("PORT")) {
+			result = (uri.getPort() != -1 ? String.valueOf(uri.getPort()) : null);
+		} else if(name.equals("PATH")) {
+			result = uri.getRawPath();
+		} else if(name.equals("QUERY")) {
+			result = uri.getRawQuery();
+		} else if(name.equals("FRAGMENT")) {
+			result = uri.getRawFragment();
+		} else {
+			throw new LOLError("Unknown URL component " + name);
+		}
+
+		// components missing from the URL are given as an empty STRIN
+		return new LOLString(result != null ? result : "");
+	}
+
+	public static LOLString DECODE(LOLString arg) throws LOLError {
+		try {
+			// URLDecoder decodes + as a space, which is only correct for form data
+			return new LOLString(URLDecoder.decode(arg.toString().replace("+", "%2B"), "UTF-8"));
+		} catch(UnsupportedEncodingException e) {
+			throw new LOLError("UTF-8 encoding is not supported");
+		} catch(IllegalArgumentException e) {
+			throw new LOLError("Malformed percent-encoding in \"" + arg.toString() + "\"");
+		}
+	}
+
+	public static LOLString ENCODE(LOLString arg) throws LOLError {
+		try {
+			// URLEncoder produces form data, so convert it to plain percent-encoding
+			return new LOLString(URLEncoder.encode(arg.toString(), "UTF-8").replace("+", "%20").replace("*", "%2A").replace("%7E", "~"));
+		} catch(UnsupportedEncodingException e) {
+			throw new LOLError("UTF-8 encoding is not supported");
+		}
+	}
+
+	public static LOLString JOIN(LOLString arg1, LOLString arg2) throws LOLError {
+		try {
+			URI base = new URI(arg1.toString());
+			String ref = arg2.toString();
+
+			// java.net.URI follows RFC 2396, so the cases where RFC 3986 differs are handled here
+			if(base.getRawAuthority() != null && base.getRawPath().equals("")) {
+				base = new URI(prefix(base) + "/" + (base.getRawQuery() != null ? "?" + base.getRawQuery() : ""));
+			}
+
+			if(ref.equals("") || ref.startsWith("?")) {
+				String result = base.toString();
+
+				if(result.contains("#")) {
+					result = result.substring(0, result.indexOf('#'));
+				}
+
+				if(ref.startsWith("?") && result.contains("?")) {
+					result = result.substring(0, result.indexOf('?'));
+				}
+
+				return new LOLString(new URI(result + ref).toString());
+			}
+
+			URI resolved = base.resolve(new URI(ref));
+			String result = resolved.toString();
+			String prefix = prefix(resolved);
+
+			if(prefix.equals("") || !result.startsWith(prefix)) {
+				return new LOLString(result);
+			}
+
+			// ".." segments cannot go above the root
+			String rest = result.substring(prefix.length());
+
+			while(rest.startsWith("/../")) {
+				rest = rest.substring(3);
+			}
+
+			if(rest.equals("/..") || rest.startsWith("/..?") || rest.startsWith("/..#")) {
+				rest = "/" + rest.substring(3);
+			}
+
+			return new LOLString(prefix + rest);
+		} catch(URISyntaxException e) {
+			throw new LOLError("Invalid URL: " + e.getMessage());
+		}
+	}
+
+	public static LOLValue QUERY_VALUE(LOLString arg1, LOLString arg2) throws LOLError {
+		for(String pair : arg1.toString().split("&")) {
+			int equals = pair.indexOf('=');
+			String key = (equals != -1 ? pair.substring(0, equals) : pair);
+			String value = (equals != -1 ? pair.substring(equals + 1) : "");
+
+			try {
+				// query strings are form data, so + is decoded as a space here
+				if(URLDecoder.decode(key, "UTF-8").equals(arg2.toString())) {
+					return new LOLString(URLDecoder.decode(value, "UTF-8"));
+				}
+			} catch(UnsupportedEncodingException e) {
+				throw new LOLError("UTF-8 encoding is not supported");
+			} catch(IllegalArgumentException e) {
+				throw new LOLError("Malformed percent-encoding in \"" + pair + "\"");
+			}
+		}
+
+		return LOLNothing.NOTHIN;
+	}
+
+	private static String prefix(URI uri) {
+		return (uri.getScheme() != null ? uri.getScheme() + ":" : "") + (uri.getRawAuthority() != null ? "//" + uri.getRawAuthority() : "");
+	}
+
+}
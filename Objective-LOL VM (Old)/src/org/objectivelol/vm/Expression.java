@@ -7,6 +7,7 @@ import org.objectivelol.lang.LOLBoolean;
 import org.objectivelol.lang.LOLClass;
 import org.objectivelol.lang.LOLError;
 import org.objectivelol.lang.LOLFunction;
+import org.objectivelol.lang.LOLInteger;
 import org.objectivelol.lang.LOLNumber;
 import org.objectivelol.lang.LOLObject;
 import org.objectivelol.lang.LOLSource;
@@ -73,7 +74,14 @@ class VariableAndNoArgFunction implements Expression {
 		
 		if(vs == null) {
 			if(owner == null) {
-				vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(name);
+				// SHARD functions run without an owner, so check their CLAS first
+				if(context.getParentClass() != null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalClass(context.getParentClass()).getSharedVariable(name, context);
+				}
+
+				if(vs == null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(name);
+				}
 			} else {
 				vs = owner.getVariable(name, context);
 
@@ -87,7 +95,13 @@ class VariableAndNoArgFunction implements Expression {
 			LOLFunction lf = null;
 
 			if(owner == null) {
-				lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalFunction(name);
+				if(context.getParentClass() != null) {
+					lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalClass(context.getParentClass()).getSharedFunction(name, context);
+				}
+
+				if(lf == null) {
+					lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalFunction(name);
+				}
 			} else {
 				lf = owner.getFunction(name, context);
 
@@ -133,7 +147,13 @@ class ArgFunction implements Expression {
 		LOLFunction lf = null;
 
 		if(owner == null) {
-			lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalFunction(name);
+			if(context.getParentClass() != null) {
+				lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalClass(context.getParentClass()).getSharedFunction(name, context);
+			}
+
+			if(lf == null) {
+				lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalFunction(name);
+			}
 		} else {
 			lf = owner.getFunction(name, context);
 
@@ -303,7 +323,11 @@ class EqualTo implements Expression {
 
 	@Override
 	public LOLValue interpret(LOLObject owner, LOLFunction context, HashMap<String, ValueStruct> localVariables) throws LOLError, Return {
-		return left.interpret(owner, context, localVariables).equalTo(right.interpret(owner, context, localVariables));
+		LOLValue l = left.interpret(owner, context, localVariables);
+		LOLValue r = right.interpret(owner, context, localVariables);
+
+		// NOTHIN cannot be cast to the type of the other side, so let it do the comparison
+		return (r.isLOLNothing() ? r.equalTo(l) : l.equalTo(r));
 	}
 
 }
@@ -384,6 +408,7 @@ class WhileStatement implements Expression {
 	@Override
 	public LOLValue interpret(LOLObject owner, LOLFunction context, HashMap<String, ValueStruct> localVariables) throws LOLError, Return {
 		while(condition.interpret(owner, context, localVariables).cast(LOLBoolean.TYPE_NAME).equalTo(LOLBoolean.YEZ).booleanValue()) {
+			RuntimeEnvironment.getRuntime().checkDeadline(context);
 			statements.interpret(owner, context, localVariables);
 		}
 
@@ -392,6 +417,74 @@ class WhileStatement implements Expression {
 
 }
 
+class DoWhileStatement implements Expression {
+
+	private Expression condition;
+	private Expression statements;
+
+	public DoWhileStatement(Expression condition, Expression statements) {
+		this.condition = condition;
+		this.statements = statements;
+	}
+
+	@Override
+	public LOLValue interpret(LOLObject owner, LOLFunction context, HashMap<String, ValueStruct> localVariables) throws LOLError, Return {
+		do {
+			RuntimeEnvironment.getRuntime().checkDeadline(context);
+			statements.interpret(owner, context, localVariables);
+		} while(condition.interpret(owner, context, localVariables).cast(LOLBoolean.TYPE_NAME).equalTo(LOLBoolean.YEZ).booleanValue());
+
+		return null;
+	}
+
+}
+
+class ForStatement implements Expression {
+
+	private String name;
+	private Expression start;
+	private Expression end;
+	private Expression step;
+	private Expression statements;
+
+	public ForStatement(String name, Expression start, Expression end, Expression step, Expression statements) {
+		this.name = name;
+		this.start = start;
+		this.end = end;
+		this.step = step;
+		this.statements = statements;
+	}
+
+	@Override
+	public LOLValue interpret(LOLObject owner, LOLFunction context, HashMap<String, ValueStruct> localVariables) throws LOLError, Return {
+		if(localVariables.containsKey(name)) {
+			throw new LOLError("Local variable identifier exists");
+		}
+
+		long i = ((LOLInteger)start.interpret(owner, context, localVariables).cast(LOLInteger.TYPE_NAME)).integerValue();
+		long e = ((LOLInteger)end.interpret(owner, context, localVariables).cast(LOLInteger.TYPE_NAME)).integerValue();
+		long s = (step != null ? ((LOLInteger)step.interpret(owner, context, localVariables).cast(LOLInteger.TYPE_NAME)).integerValue() : 1);
+
+		if(s == 0) {
+			throw new LOLError("Step of FOR statement cannot be 0");
+		}
+
+		try {
+			// the counter is a fresh locked variable on every pass and only exists inside the loop
+			for(; (s > 0 ? i < e : i > e); i += s) {
+				RuntimeEnvironment.getRuntime().checkDeadline(context);
+				localVariables.put(name, new ValueStruct(LOLInteger.TYPE_NAME, LOLValue.valueOf(i), true));
+				statements.interpret(owner, context, localVariables);
+			}
+		} finally {
+			localVariables.remove(name);
+		}
+
+		return null;
+	}
+
+}
+
 class IfStatement implements Expression {
 
 	private Expression condition;
@@ -417,6 +510,41 @@ class IfStatement implements Expression {
 
 }
 
+class SwitchStatement implements Expression {
+
+	private Expression value;
+	private ArrayList<Expression> caseValues;
+	private ArrayList<Expression> caseStatements;
+	private Expression defaultStatements;
+
+	public SwitchStatement(Expression value, ArrayList<Expression> caseValues, ArrayList<Expression> caseStatements, Expression defaultStatements) {
+		this.value = value;
+		this.caseValues = caseValues;
+		this.caseStatements = caseStatements;
+		this.defaultStatements = defaultStatements;
+	}
+
+	@Override
+	public LOLValue interpret(LOLObject owner, LOLFunction context, HashMap<String, ValueStruct> localVariables) throws LOLError, Return {
+		LOLValue v = value.interpret(owner, context, localVariables);
+
+		// only the first matching case is run, there is no fallthrough
+		for(int i = 0; i < caseValues.size(); ++i) {
+			if(v.equalTo(caseValues.get(i).interpret(owner, context, localVariables)).booleanValue()) {
+				caseStatements.get(i).interpret(owner, context, localVariables);
+				return null;
+			}
+		}
+
+		if(defaultStatements != null) {
+			defaultStatements.interpret(owner, context, localVariables);
+		}
+
+		return null;
+	}
+
+}
+
 class SimpleAssignment implements Expression {
 
 	private String name;
@@ -433,7 +561,13 @@ class SimpleAssignment implements Expression {
 
 		if(vs == null) {
 			if(owner == null) {
-				vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(name);
+				if(context.getParentClass() != null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalClass(context.getParentClass()).getSharedVariable(name, context);
+				}
+
+				if(vs == null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(name);
+				}
 			} else {
 				vs = owner.getVariable(name, context);
 
@@ -478,7 +612,13 @@ class ComplexAssignment implements Expression {
 
 		if(vs == null) {
 			if(owner == null) {
-				vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(objectName);
+				if(context.getParentClass() != null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalClass(context.getParentClass()).getSharedVariable(objectName, context);
+				}
+
+				if(vs == null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(objectName);
+				}
 			} else {
 				vs = owner.getVariable(objectName, context);
 
@@ -543,7 +683,13 @@ class MemberVariableAndNoArgFunction implements Expression {
 
 		if(vs == null) {
 			if(owner == null) {
-				vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(objectName);
+				if(context.getParentClass() != null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalClass(context.getParentClass()).getSharedVariable(objectName, context);
+				}
+
+				if(vs == null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(objectName);
+				}
 			} else {
 				vs = owner.getVariable(objectName, context);
 
@@ -557,7 +703,13 @@ class MemberVariableAndNoArgFunction implements Expression {
 			LOLFunction lf = null;
 
 			if(owner == null) {
-				lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalFunction(objectName);
+				if(context.getParentClass() != null) {
+					lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalClass(context.getParentClass()).getSharedFunction(objectName, context);
+				}
+
+				if(lf == null) {
+					lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalFunction(objectName);
+				}
 			} else {
 				lf = owner.getFunction(objectName, context);
 
@@ -664,7 +816,13 @@ class MemberArgFunction implements Expression {
 
 		if(vs == null) {
 			if(owner == null) {
-				vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(objectName);
+				if(context.getParentClass() != null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalClass(context.getParentClass()).getSharedVariable(objectName, context);
+				}
+
+				if(vs == null) {
+					vs = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalVariable(objectName);
+				}
 			} else {
 				vs = owner.getVariable(objectName, context);
 
@@ -678,7 +836,13 @@ class MemberArgFunction implements Expression {
 			LOLFunction lf = null;
 
 			if(owner == null) {
-				lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalFunction(objectName);
+				if(context.getParentClass() != null) {
+					lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalClass(context.getParentClass()).getSharedFunction(objectName, context);
+				}
+
+				if(lf == null) {
+					lf = RuntimeEnvironment.getRuntime().getSource(context.getParentSource()).getGlobalFunction(objectName);
+				}
 			} else {
 				lf = owner.getFunction(objectName, context);
 
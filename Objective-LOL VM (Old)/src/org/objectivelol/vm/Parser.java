@@ -8,6 +8,7 @@ import java.util.List;
 
 import org.objectivelol.lang.LOLError;
 import org.objectivelol.lang.LOLFunction;
+import org.objectivelol.lang.LOLNothing;
 import org.objectivelol.lang.LOLString;
 import org.objectivelol.lang.LOLValue;
 
@@ -98,6 +99,56 @@ public class Parser {
 				continue;
 			}
 
+			if(line.startsWith("FOR ")) {
+				line = line.substring(3).trim();
+
+				if(!line.contains(" FRUM ")) {
+					throw new LOLError("FRUM expected after loop variable identifier");
+				}
+
+				String name = line.substring(0, line.indexOf(" FRUM ")).trim();
+				line = line.substring(line.indexOf(" FRUM ") + 6).trim();
+
+				if(name.equals("") || name.contains(" ")) {
+					throw new LOLError("Invalid loop variable identifier");
+				}
+
+				if(!line.contains(" TIL ")) {
+					throw new LOLError("TIL expected after start of FOR statement");
+				}
+
+				String start = line.substring(0, line.indexOf(" TIL ")).trim();
+				String end = line.substring(line.indexOf(" TIL ") + 5).trim();
+				String step = null;
+
+				if(end.contains(" BY ")) {
+					step = end.substring(end.indexOf(" BY ") + 4).trim();
+					end = end.substring(0, end.indexOf(" BY ")).trim();
+				}
+
+				StringBuilder sb = new StringBuilder();
+
+				boolean first = true;
+				String line2;
+				while(!(line2 = br.readLine()).startsWith("KTHX")) {
+					if(line2.trim().equals("")) {
+						continue;
+					}
+
+					sb.append((first ? "" : "\n") + line2);
+					first = false;
+				}
+
+				if(!line2.equals("KTHX")) {
+					throw new LOLError("Unexpected symbol detected");
+				}
+
+				Expression code = parseBlock(new BufferedReader(new StringReader(sb.toString())), context);
+
+				statements.add(new ForStatement(name, parseLine(start, context), parseLine(end, context), (step != null ? parseLine(step, context) : null), code));
+				continue;
+			}
+
 			if(line.startsWith("WHILE")) {
 				line = line.substring(5).trim();
 
@@ -125,6 +176,96 @@ public class Parser {
 				continue;
 			}
 
+			if(line.startsWith("WTF ")) {
+				if(!line.endsWith("?")) {
+					throw new LOLError("Value of WTF statement must be terminated by '?'");
+				}
+
+				line = line.substring(3, line.length() - 1).trim();
+
+				ArrayList<String> caseValues = new ArrayList<String>();
+				ArrayList<StringBuilder> caseOperations = new ArrayList<StringBuilder>();
+				StringBuilder defaultOperations = null;
+				StringBuilder current = null;
+
+				String line2;
+				while(!(line2 = br.readLine()).startsWith("KTHX")) {
+					if(line2.trim().equals("")) {
+						continue;
+					}
+
+					if(line2.startsWith("OMGWTF")) {
+						if(!line2.equals("OMGWTF") || defaultOperations != null) {
+							throw new LOLError("Unexpected symbol detected");
+						}
+
+						current = defaultOperations = new StringBuilder();
+						continue;
+					}
+
+					if(line2.startsWith("OMG ")) {
+						if(defaultOperations != null) {
+							throw new LOLError("OMG cannot follow OMGWTF");
+						}
+
+						caseValues.add(line2.substring(4).trim());
+						caseOperations.add(current = new StringBuilder());
+						continue;
+					}
+
+					if(current == null) {
+						throw new LOLError("OMG or OMGWTF expected after WTF");
+					}
+
+					current.append((current.length() == 0 ? "" : "\n") + line2);
+				}
+
+				if(!line2.equals("KTHX")) {
+					throw new LOLError("Unexpected symbol detected");
+				}
+
+				ArrayList<Expression> values = new ArrayList<Expression>();
+				ArrayList<Expression> code = new ArrayList<Expression>();
+
+				for(int i = 0; i < caseValues.size(); ++i) {
+					values.add(parseLine(caseValues.get(i), context));
+					code.add(parseBlock(new BufferedReader(new StringReader(caseOperations.get(i).toString())), context));
+				}
+
+				Expression value = parseLine(line, context);
+				Expression code2 = (defaultOperations != null ? parseBlock(new BufferedReader(new StringReader(defaultOperations.toString())), context) : null);
+
+				statements.add(new SwitchStatement(value, values, code, code2));
+				continue;
+			}
+
+			if(line.equals("DO")) {
+				StringBuilder sb = new StringBuilder();
+
+				boolean first = true;
+				String line2;
+				while(!(line2 = br.readLine()).startsWith("KTHX")) {
+					if(line2.trim().equals("")) {
+						continue;
+					}
+
+					sb.append((first ? "" : "\n") + line2);
+					first = false;
+				}
+
+				if(!line2.startsWith("KTHX WHILE ")) {
+					throw new LOLError("Condition of DO statement must follow KTHX WHILE");
+				}
+
+				line2 = line2.substring(10).trim();
+
+				Expression condition = parseLine(line2, context);
+				Expression code = parseBlock(new BufferedReader(new StringReader(sb.toString())), context);
+
+				statements.add(new DoWhileStatement(condition, code));
+				continue;
+			}
+
 			statements.add(parseLine(line, context));
 		}
 
@@ -267,10 +408,10 @@ public class Parser {
 		}
 
 		if(line.startsWith("I HAS A") && tokens.get(2).equals("A")) {
-			int lockedOffset = (tokens.contains("LOCKD") ? 1 : 0);
+			int lockedOffset = (tokens.size() > 3 && tokens.get(3).equals("LOCKD") ? 1 : 0);
 
 			if(tokens.size() < 4 + lockedOffset || !tokens.get(3 + lockedOffset).equals("VARIABLE")) {
-				throw new LOLError("VARIABLE expected after I HAS A" + (lockedOffset == 1 ? " LOCKED" : ""));
+				throw new LOLError("VARIABLE expected after I HAS A" + (lockedOffset == 1 ? " LOCKD" : ""));
 			}
 
 			if(tokens.size() < 5 + lockedOffset) {
@@ -486,7 +627,8 @@ public class Parser {
 			}
 
 			if(!isStringLiteral) {
-				tokens.add(s);
+				// NOTHIN is a literal value, not the name of a variable
+				tokens.add(s.equals(LOLNothing.TYPE_NAME) ? new Value(LOLNothing.NOTHIN) : s);
 				continue;
 			} else {
 				if(!firstPartOfLiteral) {
@@ -8,12 +8,28 @@ import org.objectivelol.lang.LOLError;
 import org.objectivelol.lang.LOLFunction;
 import org.objectivelol.lang.LOLNative;
 import org.objectivelol.lang.LOLSource;
+import org.objectivelol.libs.FILEIO;
+import org.objectivelol.libs.LOG;
 import org.objectivelol.libs.MATH;
+import org.objectivelol.libs.NET;
+import org.objectivelol.libs.PATH;
 import org.objectivelol.libs.STDIO;
+import org.objectivelol.libs.STDLIB;
+import org.objectivelol.libs.STRMANIP;
+import org.objectivelol.libs.SYSTEM;
+import org.objectivelol.libs.TERM;
 import org.objectivelol.libs.TIEM;
+import org.objectivelol.libs.URL;
 
 public class RuntimeEnvironment {
 
+	/**
+	 * Version of the Objective-LOL Virtual Machine, taken from the
+	 * Implementation-Version entry of the jar manifest when the jar
+	 * is built. Builds run without a jar report "dev".
+	 */
+	public static final String VERSION = readVersion();
+
 	private static RuntimeEnvironment instance = null;
 	
 	private final HashMap<String, LOLSource> loadedSources = new HashMap<String, LOLSource>();
@@ -21,6 +37,9 @@ public class RuntimeEnvironment {
 	
 	private File execDir = new File(System.getProperty("user.dir"));
 	
+	private long maxRuntime = 0;
+	private long deadline = 0;
+	
 	private RuntimeEnvironment(File library) throws LOLError {
 		if(instance != null) {
 			throw new IllegalStateException("Cannot instantiate more than one instance of RuntimeEnvironment");
@@ -31,12 +50,30 @@ public class RuntimeEnvironment {
 				if(f.isFile()) {
 					loadSource(f);
 					
-					if(f.getName().equals("MATH.lol")) {
+					if(f.getName().equals("FILEIO.lol")) {
+						loadNative(new FILEIO());
+					} else if(f.getName().equals("LOG.lol")) {
+						loadNative(new LOG());
+					} else if(f.getName().equals("MATH.lol")) {
 						loadNative(new MATH());
+					} else if(f.getName().equals("NET.lol")) {
+						loadNative(new NET());
+					} else if(f.getName().equals("PATH.lol")) {
+						loadNative(new PATH());
 					} else if(f.getName().equals("STDIO.lol")) {
 						loadNative(new STDIO());
+					} else if(f.getName().equals("STDLIB.lol")) {
+						loadNative(new STDLIB());
+					} else if(f.getName().equals("STRMANIP.lol")) {
+						loadNative(new STRMANIP());
+					} else if(f.getName().equals("SYSTEM.lol")) {
+						loadNative(new SYSTEM());
+					} else if(f.getName().equals("TERM.lol")) {
+						loadNative(new TERM());
 					} else if(f.getName().equals("TIEM.lol")) {
 						loadNative(new TIEM());
+					} else if(f.getName().equals("URL.lol")) {
+						loadNative(new URL());
 					}
 				}
 			}
@@ -63,6 +100,13 @@ public class RuntimeEnvironment {
 		return instance;
 	}
 	
+	private static String readVersion() {
+		Package p = RuntimeEnvironment.class.getPackage();
+		String version = (p != null ? p.getImplementationVersion() : null);
+		
+		return (version != null ? version : "dev");
+	}
+	
 	public void setExecDir(File execDir) {
 		this.execDir = execDir.getAbsoluteFile();
 	}
@@ -71,6 +115,24 @@ public class RuntimeEnvironment {
 		return execDir;
 	}
 	
+	public void setMaxRuntime(long maxRuntime) {
+		this.maxRuntime = maxRuntime;
+	}
+	
+	public void checkDeadline(LOLFunction context) throws LOLError {
+		// a deadline of 0 means that the runtime is not limited
+		if(deadline != 0 && System.currentTimeMillis() > deadline) {
+			throw new LOLError("Execution exceeded the maximum runtime of " + maxRuntime + " ms in function " + context.getName());
+		}
+	}
+	
+	public File resolveFile(String path) {
+		File f = new File(path);
+
+		// relative paths in a program are relative to the execution directory, not the JVM's
+		return (f.isAbsolute() ? f : new File(execDir, path));
+	}
+	
 	public void loadSource(File file) throws LOLError {
 		SourceParser sp = new SourceParser(file);
 		LOLSource result = sp.parse();
@@ -104,6 +166,10 @@ public class RuntimeEnvironment {
 	}
 	
 	public void execute() throws LOLError {
+		if(maxRuntime > 0) {
+			deadline = System.currentTimeMillis() + maxRuntime;
+		}
+		
 		for(LOLSource s : loadedSources.values()) {
 			for(LOLFunction f : s.getGlobalFunctions()) {
 				if(f.getName().equals("MAIN")) {
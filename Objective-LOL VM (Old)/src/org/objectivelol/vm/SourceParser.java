@@ -304,7 +304,7 @@ public class SourceParser {
 							continue;
 						}
 
-						if(line.startsWith("DIS TEH VARIABLE") || line.startsWith("DIS TEH LOCKD VARIABLE") || line.startsWith("DIS TEH LOCKD SHARD VARIABLE") || line.startsWith("DIS TEH SHARD LOCKD VARIABLE")) {
+						if(line.startsWith("DIS TEH VARIABLE") || line.startsWith("DIS TEH LOCKD VARIABLE") || line.startsWith("DIS TEH SHARD VARIABLE") || line.startsWith("DIS TEH LOCKD SHARD VARIABLE") || line.startsWith("DIS TEH SHARD LOCKD VARIABLE")) {
 							String[] tokens1 = line.split(" ");
 
 							String type;
@@ -499,14 +499,14 @@ public class SourceParser {
 								}
 
 								if(line.startsWith("KTHX")) {
-									if(!line.equals("KTHX")) {
+									if(!line.equals("KTHX") && !line.startsWith("KTHX WHILE ")) {
 										throw new LOLError("Line " + lineNumber + ": Unexpected symbol detected");
 									}
 
 									nests--;
 								}
 
-								if(line.startsWith("IZ") || line.startsWith("WHILE")) {
+								if(line.startsWith("IZ") || line.startsWith("WHILE") || line.equals("DO") || line.startsWith("WTF ") || line.startsWith("FOR ")) {
 									nests++;
 								}
 